@@ -1,18 +1,25 @@
 package admin
 
+//go:generate go run ./cmd/admin-gen -spec ./cmd/admin-gen/testdata/admin.json -out admin_gen.go -package admin
+
 // Test sync: 2026-02-04
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
+	"math/rand"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/paanj-cloud/admin-go/client/gen"
 )
 
 // AdminHttpClient
@@ -20,6 +27,12 @@ type AdminHttpClient struct {
 	secretKey string
 	apiUrl    string
 	client    *http.Client
+
+	requestInterceptors  []gen.RequestInterceptor
+	responseInterceptors []gen.ResponseInterceptor
+
+	metrics Metrics
+	tracer  Tracer
 }
 
 func NewAdminHttpClient(secretKey, apiUrl string) *AdminHttpClient {
@@ -27,10 +40,79 @@ func NewAdminHttpClient(secretKey, apiUrl string) *AdminHttpClient {
 		secretKey: secretKey,
 		apiUrl:    apiUrl,
 		client:    &http.Client{},
+		metrics:   noopMetrics{},
+		tracer:    noopTracer{},
 	}
 }
 
+// AddRequestInterceptor registers an interceptor to run on every outgoing
+// request, in registration order, before it's sent.
+func (c *AdminHttpClient) AddRequestInterceptor(interceptor gen.RequestInterceptor) {
+	c.requestInterceptors = append(c.requestInterceptors, interceptor)
+}
+
+// AddResponseInterceptor registers an interceptor to run on every response,
+// in registration order, before its body is decoded.
+func (c *AdminHttpClient) AddResponseInterceptor(interceptor gen.ResponseInterceptor) {
+	c.responseInterceptors = append(c.responseInterceptors, interceptor)
+}
+
 func (c *AdminHttpClient) Request(method, path string, body interface{}) (map[string]interface{}, error) {
+	responseBody, err := c.RequestCtx(context.Background(), method, path, body)
+	if err != nil {
+		var apiErr *gen.APIError
+		if errors.As(err, &apiErr) {
+			return nil, fmt.Errorf("api error: status=%d body=%s", apiErr.Status, string(apiErr.Raw))
+		}
+		return nil, err
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return nil, nil
+	}
+
+	return result, nil
+}
+
+// RequestTyped is RequestCtx with the response body decoded straight into
+// out, returning a *gen.APIError for 4xx/5xx responses instead of the
+// generic "api error: ..." string error Request uses. out may be nil to
+// discard the body.
+func (c *AdminHttpClient) RequestTyped(ctx context.Context, method, path string, body interface{}, out interface{}) error {
+	responseBody, err := c.RequestCtx(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(responseBody) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(responseBody, out); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return nil
+}
+
+// RequestCtx is Request with context.Context support and request/response
+// interceptors, returning the raw response body. Request and RequestTyped
+// are both built on top of it.
+func (c *AdminHttpClient) RequestCtx(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	ctx, endSpan := c.tracer.StartSpan(ctx, "admin.http "+method+" "+path)
+	start := time.Now()
+	c.metrics.IncRequestsTotal(method, path)
+
+	responseBody, err := c.doRequest(ctx, method, path, body)
+
+	c.metrics.ObserveRequestDuration(method, path, time.Since(start).Seconds())
+	if err != nil {
+		c.metrics.IncRequestErrorsTotal(method, path)
+	}
+	endSpan(err)
+
+	return responseBody, err
+}
+
+func (c *AdminHttpClient) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
 	var bodyReader io.Reader
 	if body != nil {
 		jsonBody, err := json.Marshal(body)
@@ -40,7 +122,7 @@ func (c *AdminHttpClient) Request(method, path string, body interface{}) (map[st
 		bodyReader = bytes.NewBuffer(jsonBody)
 	}
 
-	req, err := http.NewRequest(method, c.apiUrl+path, bodyReader)
+	req, err := http.NewRequestWithContext(ctx, method, c.apiUrl+path, bodyReader)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -48,23 +130,104 @@ func (c *AdminHttpClient) Request(method, path string, body interface{}) (map[st
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-API-Key", c.secretKey) // JS SDK uses X-API-Key for admin secret
 
+	if err := gen.RunRequestInterceptors(c.requestInterceptors, req); err != nil {
+		return nil, fmt.Errorf("request interceptor failed: %w", err)
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode >= 400 {
-		responseBody, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("api error: status=%d body=%s", resp.StatusCode, string(responseBody))
+	if err := gen.RunResponseInterceptors(c.responseInterceptors, resp); err != nil {
+		return nil, fmt.Errorf("response interceptor failed: %w", err)
 	}
 
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return nil, nil
+	responseBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	return result, nil
+	if resp.StatusCode >= 400 {
+		return nil, gen.ParseAPIError(resp.StatusCode, responseBody)
+	}
+
+	return responseBody, nil
+}
+
+// defaultPingInterval, defaultPongWait and defaultWriteWait mirror the
+// keepalive cadence used by the Tendermint and msgbus WS clients: pings
+// go out at half the pong deadline, so each pong pushes the read deadline
+// forward before it lapses. One missed pong is enough to let it lapse: the
+// reply to the next ping arrives just after the deadline.
+const (
+	defaultPingInterval         = 30 * time.Second
+	defaultPongWait             = 60 * time.Second
+	defaultWriteWait            = 10 * time.Second
+	defaultMaxReconnectInterval = 64 * time.Second
+	stableConnectionThreshold   = 60 * time.Second
+)
+
+// Response is a correlated reply to a Request or RequestWithTimeout call,
+// matched back to its caller by the id the client assigned when sending.
+type Response struct {
+	ID    uint64
+	Type  string
+	Data  json.RawMessage
+	Error string
+}
+
+// pendingRequest tracks one in-flight Request/RequestWithTimeout call.
+// expiresAt is zero for Request calls, which rely on ctx instead of the
+// sweeper.
+type pendingRequest struct {
+	id        uint64
+	ch        chan *Response
+	expiresAt time.Time
+	onExpire  func(id uint64)
+}
+
+// expirySweepInterval is how often the pending-request map is scanned for
+// entries past their deadline.
+const expirySweepInterval = time.Second
+
+// defaultChanBufferSize is the default buffer depth for channels returned
+// by SubscribeCh.
+const defaultChanBufferSize = 16
+
+// SubscriptionManager records the active subscriptions for an
+// AdminWebSocketClient, keyed by topic, so they can be replayed after a
+// reconnect.
+type SubscriptionManager struct {
+	mu   sync.Mutex
+	subs map[string]interface{}
+}
+
+func newSubscriptionManager() *SubscriptionManager {
+	return &SubscriptionManager{subs: make(map[string]interface{})}
+}
+
+func (m *SubscriptionManager) add(topic string, payload interface{}) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.subs[topic] = payload
+}
+
+func (m *SubscriptionManager) remove(topic string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.subs, topic)
+}
+
+func (m *SubscriptionManager) all() map[string]interface{} {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make(map[string]interface{}, len(m.subs))
+	for topic, payload := range m.subs {
+		out[topic] = payload
+	}
+	return out
 }
 
 // AdminWebSocketClient
@@ -77,18 +240,54 @@ type AdminWebSocketClient struct {
 	eventHandlers        map[string][]func(interface{})
 	autoReconnect        bool
 	reconnectInterval    time.Duration
+	maxReconnectInterval time.Duration
 	maxReconnectAttempts int
+	reconnectAttempt     int
+	pingInterval         time.Duration
+	pongWait             time.Duration
+	writeWait            time.Duration
+	stopPing             chan struct{}
+	hasConnectedBefore   bool
+
+	requestSeq      uint64
+	reqMu           sync.Mutex
+	pendingRequests map[uint64]*pendingRequest
+	stopSweep       chan struct{}
+	stopSweepOnce   sync.Once
+
+	subs               *SubscriptionManager
+	chanSubs           map[string]chan interface{}
+	slowConsumerPolicy SlowConsumerPolicy
+	chanBufferSize     int
+
+	metrics Metrics
+	tracer  Tracer
 }
 
 func NewAdminWebSocketClient(secretKey, wsUrl string, autoReconnect bool, interval time.Duration, maxAttempts int) *AdminWebSocketClient {
-	return &AdminWebSocketClient{
+	c := &AdminWebSocketClient{
 		secretKey:            secretKey,
 		wsUrl:                wsUrl,
 		autoReconnect:        autoReconnect,
 		reconnectInterval:    interval,
+		maxReconnectInterval: defaultMaxReconnectInterval,
 		maxReconnectAttempts: maxAttempts,
+		pingInterval:         defaultPingInterval,
+		pongWait:             defaultPongWait,
+		writeWait:            defaultWriteWait,
 		eventHandlers:        make(map[string][]func(interface{})),
+		pendingRequests:      make(map[uint64]*pendingRequest),
+		subs:                 newSubscriptionManager(),
+		chanSubs:             make(map[string]chan interface{}),
+		chanBufferSize:       defaultChanBufferSize,
+		stopSweep:            make(chan struct{}),
+		metrics:              noopMetrics{},
+		tracer:               noopTracer{},
 	}
+
+	go c.expirySweeper()
+
+	return c
 }
 
 func (c *AdminWebSocketClient) Connect() error {
@@ -107,10 +306,25 @@ func (c *AdminWebSocketClient) Connect() error {
 		return err
 	}
 
+	conn.SetPongHandler(func(string) error {
+		return conn.SetReadDeadline(time.Now().Add(c.pongWait))
+	})
+
 	c.conn = conn
 	c.isConnected = true
-
-	go c.listen()
+	c.stopPing = make(chan struct{})
+	isReconnect := c.hasConnectedBefore
+	c.hasConnectedBefore = true
+	c.metrics.SetWSConnected(true)
+
+	connectedAt := time.Now()
+	go c.keepalive(conn, c.stopPing)
+	go c.listen(conn, connectedAt)
+
+	if isReconnect {
+		c.metrics.IncWSReconnectsTotal()
+		go c.onReconnected()
+	}
 
 	return nil
 }
@@ -122,46 +336,325 @@ func (c *AdminWebSocketClient) Disconnect() {
 	if c.conn != nil {
 		c.conn.Close()
 		c.isConnected = false
+		c.metrics.SetWSConnected(false)
+	}
+	if c.stopPing != nil {
+		close(c.stopPing)
+		c.stopPing = nil
+	}
+	c.stopSweepOnce.Do(func() { close(c.stopSweep) })
+}
+
+// onReconnected fires the synthetic "reconnected" event so user code can
+// reset local state, then replays every active subscription against the
+// new connection.
+func (c *AdminWebSocketClient) onReconnected() {
+	c.mu.Lock()
+	handlers := c.eventHandlers["reconnected"]
+	c.mu.Unlock()
+
+	for _, handler := range handlers {
+		go handler(nil)
+	}
+
+	for topic, payload := range c.subs.all() {
+		if err := c.sendSubscribe(topic, payload); err != nil {
+			log.Println("ws resubscribe error:", err, "topic:", topic)
+		}
 	}
 }
 
-func (c *AdminWebSocketClient) listen() {
+// keepalive sends a websocket ping on pingInterval until stop is closed or
+// the write fails, at which point it closes conn so listen's ReadMessage
+// unblocks and the reconnect path takes over.
+func (c *AdminWebSocketClient) keepalive(conn *websocket.Conn, stop chan struct{}) {
+	ticker := time.NewTicker(c.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			c.mu.Lock()
+			conn.SetWriteDeadline(time.Now().Add(c.writeWait))
+			err := conn.WriteMessage(websocket.PingMessage, nil)
+			c.mu.Unlock()
+			if err != nil {
+				log.Println("ws ping error:", err)
+				conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (c *AdminWebSocketClient) listen(conn *websocket.Conn, connectedAt time.Time) {
 	defer func() {
 		c.mu.Lock()
 		c.isConnected = false
+		if c.stopPing != nil {
+			close(c.stopPing)
+			c.stopPing = nil
+		}
+		autoReconnect := c.autoReconnect
 		c.mu.Unlock()
-		if c.autoReconnect {
-			time.Sleep(c.reconnectInterval)
-			c.Connect()
+
+		c.metrics.SetWSConnected(false)
+
+		if autoReconnect {
+			c.scheduleReconnect(connectedAt)
 		}
 	}()
 
+	conn.SetReadDeadline(time.Now().Add(c.pongWait))
+
 	for {
-		_, message, err := c.conn.ReadMessage()
+		_, message, err := conn.ReadMessage()
 		if err != nil {
 			log.Println("ws read error:", err)
 			return
 		}
 
-		var eventData struct {
-			Type string      `json:"type"`
-			Data interface{} `json:"data"`
+		var frame struct {
+			ID    uint64          `json:"id"`
+			Type  string          `json:"type"`
+			Data  json.RawMessage `json:"data"`
+			Error string          `json:"error,omitempty"`
 		}
 
-		if err := json.Unmarshal(message, &eventData); err != nil {
+		if err := json.Unmarshal(message, &frame); err != nil {
 			continue
 		}
 
+		if frame.ID != 0 {
+			c.reqMu.Lock()
+			pending, ok := c.pendingRequests[frame.ID]
+			if ok {
+				delete(c.pendingRequests, frame.ID)
+			}
+			c.reqMu.Unlock()
+
+			if ok {
+				pending.ch <- &Response{ID: frame.ID, Type: frame.Type, Data: frame.Data, Error: frame.Error}
+				continue
+			}
+		}
+
+		c.metrics.IncWSMessagesReceivedTotal(frame.Type)
+
+		var data interface{}
+		if len(frame.Data) > 0 {
+			if err := json.Unmarshal(frame.Data, &data); err != nil {
+				continue
+			}
+		}
+
 		c.mu.Lock()
-		handlers := c.eventHandlers[eventData.Type]
+		handlers := c.eventHandlers[frame.Type]
+		ch, hasChan := c.chanSubs[frame.Type]
 		c.mu.Unlock()
 
 		for _, handler := range handlers {
-			go handler(eventData.Data)
+			go c.dispatch(frame.Type, handler, data)
+		}
+
+		if hasChan {
+			c.deliverToChannel(ch, data, frame.Type)
 		}
 	}
 }
 
+// dispatch runs one event handler inside an OTel-shaped span and records
+// ws_message_dispatch_seconds, so slow handlers show up in both traces and
+// metrics.
+func (c *AdminWebSocketClient) dispatch(eventType string, handler func(interface{}), data interface{}) {
+	_, endSpan := c.tracer.StartSpan(context.Background(), "admin.ws.handler "+eventType)
+	start := time.Now()
+
+	handler(data)
+
+	c.metrics.ObserveWSMessageDispatchSeconds(eventType, time.Since(start).Seconds())
+	endSpan(nil)
+}
+
+// nextRequestID returns the next id to stamp on an outgoing request frame.
+// IDs start at 1 so that 0 can mean "not a request" when routing inbound
+// frames in listen.
+func (c *AdminWebSocketClient) nextRequestID() uint64 {
+	return atomic.AddUint64(&c.requestSeq, 1)
+}
+
+func (c *AdminWebSocketClient) registerPending(id uint64, expiresAt time.Time, onExpire func(id uint64)) chan *Response {
+	ch := make(chan *Response, 1)
+	c.reqMu.Lock()
+	c.pendingRequests[id] = &pendingRequest{id: id, ch: ch, expiresAt: expiresAt, onExpire: onExpire}
+	c.reqMu.Unlock()
+	return ch
+}
+
+func (c *AdminWebSocketClient) removePending(id uint64) {
+	c.reqMu.Lock()
+	delete(c.pendingRequests, id)
+	c.reqMu.Unlock()
+}
+
+// expirySweeper periodically evicts pending requests past their deadline,
+// firing onExpire for each and closing its channel so a blocked
+// RequestWithTimeout caller unblocks. This is what bounds pendingRequests
+// when the server drops a connection mid-request instead of replying. It
+// runs for the life of the client and exits once Disconnect closes
+// stopSweep, the same way keepalive exits once stopPing is closed.
+func (c *AdminWebSocketClient) expirySweeper() {
+	ticker := time.NewTicker(expirySweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stopSweep:
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+
+		var expired []*pendingRequest
+		c.reqMu.Lock()
+		for id, p := range c.pendingRequests {
+			if !p.expiresAt.IsZero() && now.After(p.expiresAt) {
+				expired = append(expired, p)
+				delete(c.pendingRequests, id)
+			}
+		}
+		c.reqMu.Unlock()
+
+		for _, p := range expired {
+			close(p.ch)
+			if p.onExpire != nil {
+				go p.onExpire(p.id)
+			}
+		}
+	}
+}
+
+// Request sends method/params as a framed {id,type,data} message and blocks
+// until a reply carrying the same id arrives or ctx is done. It returns an
+// error early only if the frame can't be sent; a connection that drops
+// after the send does not end the wait.
+func (c *AdminWebSocketClient) Request(ctx context.Context, method string, params interface{}) (json.RawMessage, error) {
+	id := c.nextRequestID()
+	ch := c.registerPending(id, time.Time{}, nil)
+
+	frame := map[string]interface{}{
+		"id":   id,
+		"type": method,
+		"data": params,
+	}
+
+	if err := c.Send(frame); err != nil {
+		c.removePending(id)
+		return nil, err
+	}
+
+	select {
+	case resp := <-ch:
+		if resp.Error != "" {
+			return nil, fmt.Errorf("admin error: %s", resp.Error)
+		}
+		return resp.Data, nil
+	case <-ctx.Done():
+		c.removePending(id)
+		return nil, ctx.Err()
+	}
+}
+
+// RequestWithTimeout sends msg (a JSON-marshalable object, typically a map
+// or struct with a "type" field) with a generated id merged in, and blocks
+// until a matching reply arrives or timeout elapses. If the request
+// expires, onExpire is invoked with the request's id; onExpire may be nil.
+func (c *AdminWebSocketClient) RequestWithTimeout(msg interface{}, timeout time.Duration, onExpire func(id uint64)) (json.RawMessage, error) {
+	id := c.nextRequestID()
+
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	var frame map[string]interface{}
+	if err := json.Unmarshal(body, &frame); err != nil {
+		return nil, fmt.Errorf("request must encode to a JSON object: %w", err)
+	}
+	frame["id"] = id
+
+	ch := c.registerPending(id, time.Now().Add(timeout), onExpire)
+
+	if err := c.Send(frame); err != nil {
+		c.removePending(id)
+		return nil, err
+	}
+
+	resp, ok := <-ch
+	if !ok {
+		return nil, fmt.Errorf("request %d timed out after %s", id, timeout)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("admin error: %s", resp.Error)
+	}
+	return resp.Data, nil
+}
+
+// backoffDelay returns the capped exponential delay for the given
+// zero-based reconnect attempt, before scheduleReconnect applies jitter.
+// Factored out of scheduleReconnect so the (overflow-prone) shift-and-cap
+// arithmetic can be tested without driving a real reconnect loop.
+func backoffDelay(base time.Duration, attempt int, max time.Duration) time.Duration {
+	delay := base << uint(attempt)
+	if delay <= 0 || delay > max {
+		delay = max
+	}
+	return delay
+}
+
+// scheduleReconnect backs off exponentially from reconnectInterval up to
+// maxReconnectInterval, waiting a random delay between half and all of the
+// backoff so a fleet of clients reconnecting
+// after the same outage doesn't thunder against the server. A connection
+// that stayed up for stableConnectionThreshold resets the attempt counter.
+// It loops rather than recursing on a failed Connect so an outage that
+// outlasts thousands of attempts doesn't grow the goroutine's stack by one
+// frame per attempt; connectedAt only matters for the first iteration; a
+// failed retry always starts the next one from a zero connectedAt, same as
+// the recursive call it replaced.
+func (c *AdminWebSocketClient) scheduleReconnect(connectedAt time.Time) {
+	for {
+		c.mu.Lock()
+		if !connectedAt.IsZero() && time.Since(connectedAt) >= stableConnectionThreshold {
+			c.reconnectAttempt = 0
+		}
+
+		if c.maxReconnectAttempts > 0 && c.reconnectAttempt >= c.maxReconnectAttempts {
+			c.mu.Unlock()
+			log.Printf("ws giving up after %d reconnect attempts", c.reconnectAttempt)
+			return
+		}
+
+		attempt := c.reconnectAttempt
+		c.reconnectAttempt++
+		c.mu.Unlock()
+
+		delay := backoffDelay(c.reconnectInterval, attempt, c.maxReconnectInterval)
+		delay = delay/2 + time.Duration(rand.Int63n(int64(delay/2+1)))
+		time.Sleep(delay)
+
+		err := c.Connect()
+		if err == nil {
+			return
+		}
+		log.Println("ws reconnect error:", err)
+		connectedAt = time.Time{}
+	}
+}
+
 func (c *AdminWebSocketClient) Send(data interface{}) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -185,13 +678,106 @@ func (c *AdminWebSocketClient) IsConnectedStatus() bool {
 	return c.isConnected
 }
 
-func (c *AdminWebSocketClient) Subscribe(subscription interface{}) error {
+// Subscribe sends a subscribe frame for topic and records it so that
+// onReconnected can re-establish it with the server after a
+// reconnect. subscription is the topic-specific payload (filters, params,
+// etc.) sent alongside the topic.
+func (c *AdminWebSocketClient) Subscribe(topic string, subscription interface{}) error {
+	c.subs.add(topic, subscription)
+	return c.sendSubscribe(topic, subscription)
+}
+
+func (c *AdminWebSocketClient) sendSubscribe(topic string, subscription interface{}) error {
 	return c.Send(map[string]interface{}{
-		"type": "subscribe",
-		"data": subscription,
+		"type":  "subscribe",
+		"topic": topic,
+		"data":  subscription,
 	})
 }
 
+// Unsubscribe removes topic from the replay set and tells the server to
+// stop sending it.
+func (c *AdminWebSocketClient) Unsubscribe(topic string) error {
+	c.subs.remove(topic)
+	return c.Send(map[string]interface{}{
+		"type":  "unsubscribe",
+		"topic": topic,
+	})
+}
+
+// SlowConsumerPolicy controls what SubscribeCh does when a channel
+// subscriber isn't draining its channel fast enough.
+type SlowConsumerPolicy int
+
+const (
+	// SlowConsumerDropOldest discards the oldest buffered message to make
+	// room for the new one.
+	SlowConsumerDropOldest SlowConsumerPolicy = iota
+	// SlowConsumerDisconnect closes the websocket connection, triggering
+	// the normal reconnect path, rather than letting one slow subscriber
+	// fall further and further behind.
+	SlowConsumerDisconnect
+)
+
+// SubscribeCh subscribes to topic like Subscribe, but delivers events over
+// a buffered channel instead of a callback so callers don't have to manage
+// their own mutex/callback plumbing. The returned func unsubscribes and
+// stops delivery.
+func (c *AdminWebSocketClient) SubscribeCh(topic string, subscription interface{}) (<-chan interface{}, func()) {
+	ch := make(chan interface{}, c.chanBufferSize)
+
+	c.mu.Lock()
+	c.chanSubs[topic] = ch
+	c.mu.Unlock()
+
+	if err := c.Subscribe(topic, subscription); err != nil {
+		log.Println("ws subscribe error:", err)
+	}
+
+	unsubscribe := func() {
+		c.mu.Lock()
+		delete(c.chanSubs, topic)
+		c.mu.Unlock()
+
+		if err := c.Unsubscribe(topic); err != nil {
+			log.Println("ws unsubscribe error:", err)
+		}
+	}
+
+	return ch, unsubscribe
+}
+
+// deliverToChannel applies slowConsumerPolicy when ch's buffer is full.
+func (c *AdminWebSocketClient) deliverToChannel(ch chan interface{}, data interface{}, topic string) {
+	select {
+	case ch <- data:
+		return
+	default:
+	}
+
+	switch c.slowConsumerPolicy {
+	case SlowConsumerDisconnect:
+		log.Println("ws slow consumer on topic, disconnecting:", topic)
+		// Close only the connection, as keepalive does, so listen exits
+		// through the reconnect path; Disconnect would also stop
+		// expirySweeper for the rest of the client's life.
+		c.mu.Lock()
+		if c.conn != nil {
+			c.conn.Close()
+		}
+		c.mu.Unlock()
+	default:
+		select {
+		case <-ch:
+		default:
+		}
+		select {
+		case ch <- data:
+		default:
+		}
+	}
+}
+
 // PaanjAdmin
 type AdminOptions struct {
 	ApiUrl               string
@@ -199,13 +785,31 @@ type AdminOptions struct {
 	AutoReconnect        bool
 	ReconnectInterval    time.Duration
 	MaxReconnectAttempts int
+	MaxReconnectInterval time.Duration
+	PingInterval         time.Duration
+	PongWait             time.Duration
+	WriteWait            time.Duration
+	SlowConsumerPolicy   SlowConsumerPolicy
+	ChannelBufferSize    int
+	Transport            Transport
+	TransportPreference  []TransportKind
+	Metrics              Metrics
+	Tracer               Tracer
 }
 
 type PaanjAdmin struct {
-	secretKey  string
-	wsClient   *AdminWebSocketClient
+	secretKey string
+
+	mu        sync.Mutex
+	transport Transport
+
+	candidates []Transport
 	httpClient *AdminHttpClient
 	options    AdminOptions
+
+	// pending holds Subscribe calls made before Connect has picked a
+	// transport, replayed once one connects.
+	pending *SubscriptionManager
 }
 
 func NewAdmin(secretKey string, options AdminOptions) *PaanjAdmin {
@@ -222,49 +826,159 @@ func NewAdmin(secretKey string, options AdminOptions) *PaanjAdmin {
 	if params.MaxReconnectAttempts == 0 {
 		params.MaxReconnectAttempts = 10
 	}
+	if params.MaxReconnectInterval == 0 {
+		params.MaxReconnectInterval = defaultMaxReconnectInterval
+	}
+	if params.PingInterval == 0 {
+		params.PingInterval = defaultPingInterval
+	}
+	if params.PongWait == 0 {
+		params.PongWait = defaultPongWait
+	}
+	if params.WriteWait == 0 {
+		params.WriteWait = defaultWriteWait
+	}
+	if params.ChannelBufferSize == 0 {
+		params.ChannelBufferSize = defaultChanBufferSize
+	}
+	if params.Metrics == nil {
+		params.Metrics = noopMetrics{}
+	}
+	if params.Tracer == nil {
+		params.Tracer = noopTracer{}
+	}
 
 	admin := &PaanjAdmin{
 		secretKey: secretKey,
 		options:   params,
+		pending:   newSubscriptionManager(),
 	}
 
-	admin.wsClient = NewAdminWebSocketClient(
-		secretKey,
-		params.WsUrl,
-		params.AutoReconnect,
-		params.ReconnectInterval,
-		params.MaxReconnectAttempts,
-	)
+	if params.Transport != nil {
+		admin.candidates = []Transport{params.Transport}
+	} else {
+		prefs := params.TransportPreference
+		if len(prefs) == 0 {
+			prefs = []TransportKind{TransportWS}
+		}
+		for _, kind := range prefs {
+			admin.candidates = append(admin.candidates, buildTransport(kind, secretKey, params))
+		}
+	}
 
 	admin.httpClient = NewAdminHttpClient(secretKey, params.ApiUrl)
+	admin.httpClient.metrics = params.Metrics
+	admin.httpClient.tracer = params.Tracer
 
 	return admin
 }
 
+// Connect tries each candidate transport in TransportPreference order,
+// keeping the first one that connects successfully, then replays any
+// Subscribe calls made before this succeeded.
 func (c *PaanjAdmin) Connect() error {
-	return c.wsClient.Connect()
+	transport, err := connectTransports(c.candidates)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.transport = transport
+	c.mu.Unlock()
+
+	for topic, subscription := range c.pending.all() {
+		if err := transport.Subscribe(topic, subscription); err != nil {
+			return err
+		}
+		c.pending.remove(topic)
+	}
+	return nil
 }
 
 func (c *PaanjAdmin) Disconnect() {
-	c.wsClient.Disconnect()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.transport != nil {
+		c.transport.Close()
+	}
 }
 
 func (c *PaanjAdmin) IsConnected() bool {
-	return c.wsClient.IsConnectedStatus()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ws, ok := c.transport.(*wsTransport); ok {
+		return ws.client.IsConnectedStatus()
+	}
+	return c.transport != nil
 }
 
+// GetWebSocket returns the underlying AdminWebSocketClient, or nil if the
+// active transport isn't websocket-based.
 func (c *PaanjAdmin) GetWebSocket() *AdminWebSocketClient {
-	return c.wsClient
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ws, ok := c.transport.(*wsTransport); ok {
+		return ws.client
+	}
+	return nil
 }
 
 func (c *PaanjAdmin) GetHttpClient() *AdminHttpClient {
 	return c.httpClient
 }
 
-func (c *PaanjAdmin) Subscribe(subscription interface{}) error {
-	return c.wsClient.Subscribe(subscription)
+// Subscribe sends topic to the active transport, or, if Connect hasn't
+// picked one yet, buffers it to be sent once Connect succeeds.
+func (c *PaanjAdmin) Subscribe(topic string, subscription interface{}) error {
+	c.mu.Lock()
+	transport := c.transport
+	c.mu.Unlock()
+
+	if transport == nil {
+		c.pending.add(topic, subscription)
+		return nil
+	}
+	return transport.Subscribe(topic, subscription)
+}
+
+// Unsubscribe is only supported over the websocket transport; SSE and
+// long-poll have no replay set to remove a topic from.
+func (c *PaanjAdmin) Unsubscribe(topic string) error {
+	c.pending.remove(topic)
+
+	c.mu.Lock()
+	transport := c.transport
+	c.mu.Unlock()
+
+	ws, ok := transport.(*wsTransport)
+	if !ok {
+		return fmt.Errorf("unsubscribe is not supported by the active transport")
+	}
+	return ws.client.Unsubscribe(topic)
 }
 
+// SubscribeCh is only supported over the websocket transport; SSE and
+// long-poll deliver events through On instead.
+func (c *PaanjAdmin) SubscribeCh(topic string, subscription interface{}) (<-chan interface{}, func(), error) {
+	c.mu.Lock()
+	transport := c.transport
+	c.mu.Unlock()
+
+	ws, ok := transport.(*wsTransport)
+	if !ok {
+		return nil, nil, fmt.Errorf("SubscribeCh is not supported by the active transport")
+	}
+	ch, unsubscribe := ws.client.SubscribeCh(topic, subscription)
+	return ch, unsubscribe, nil
+}
+
+// On registers callback on every candidate transport, not just the one
+// Connect ends up choosing: candidates already exist once NewAdmin returns,
+// On only does local bookkeeping (no network call), and this lets callers
+// (including the generated OnXxx helpers) register handlers before Connect
+// without the call panicking on a nil transport.
 func (c *PaanjAdmin) On(event string, callback func(interface{})) {
-	c.wsClient.On(event, callback)
+	for _, candidate := range c.candidates {
+		candidate.On(event, callback)
+	}
 }
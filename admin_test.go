@@ -0,0 +1,48 @@
+package admin
+
+import (
+	"testing"
+)
+
+// TestPaanjAdminOnBeforeConnect guards the nil-pointer panic: On used to
+// call straight through to c.transport, which is nil until Connect
+// succeeds. This is the pattern OnTyped/the generated OnXxx helpers rely on.
+func TestPaanjAdminOnBeforeConnect(t *testing.T) {
+	transport := &fakeTransport{}
+	admin := NewAdmin("secret", AdminOptions{Transport: transport})
+
+	var called bool
+	admin.On("user.created", func(interface{}) { called = true })
+
+	if len(transport.handlers["user.created"]) != 1 {
+		t.Fatalf("On() registered on %d candidates, want 1", len(transport.handlers["user.created"]))
+	}
+
+	transport.handlers["user.created"][0](nil)
+	if !called {
+		t.Error("callback registered by On() before Connect was never invoked")
+	}
+}
+
+// TestPaanjAdminSubscribeBeforeConnect guards the same nil-transport panic
+// for Subscribe: a Subscribe call made before Connect must be buffered and
+// replayed once a transport connects, not dropped or panicked on.
+func TestPaanjAdminSubscribeBeforeConnect(t *testing.T) {
+	transport := &fakeTransport{}
+	admin := NewAdmin("secret", AdminOptions{Transport: transport})
+
+	if err := admin.Subscribe("orders", map[string]string{"region": "us"}); err != nil {
+		t.Fatalf("Subscribe() before Connect error = %v", err)
+	}
+	if len(transport.subscribed) != 0 {
+		t.Fatalf("Subscribe() before Connect reached the transport immediately, want it buffered")
+	}
+
+	if err := admin.Connect(); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	if got := transport.subscribed["orders"]; got == nil {
+		t.Fatal("buffered Subscribe() was never replayed onto the transport after Connect")
+	}
+}
@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffDelay(t *testing.T) {
+	const (
+		base = time.Second
+		max  = 64 * time.Second
+	)
+
+	tests := []struct {
+		name    string
+		attempt int
+		want    time.Duration
+	}{
+		{name: "first attempt is the base interval", attempt: 0, want: base},
+		{name: "doubles each attempt", attempt: 3, want: 8 * time.Second},
+		{name: "caps exactly at max", attempt: 6, want: max},
+		{name: "caps well past max", attempt: 10, want: max},
+		{name: "caps when the shift overflows", attempt: 63, want: max},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := backoffDelay(base, tt.attempt, max); got != tt.want {
+				t.Errorf("backoffDelay(%s, %d, %s) = %s, want %s", base, tt.attempt, max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffDelayNeverExceedsMax(t *testing.T) {
+	const max = 30 * time.Second
+
+	for attempt := 0; attempt < 100; attempt++ {
+		if got := backoffDelay(500*time.Millisecond, attempt, max); got > max {
+			t.Fatalf("backoffDelay(..., %d, %s) = %s, exceeds max", attempt, max, got)
+		}
+	}
+}
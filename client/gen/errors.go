@@ -0,0 +1,54 @@
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// APIError is the typed error generated methods return for a 4xx/5xx
+// admin API response, replacing the "api error: status=%d body=%s" string
+// error that map[string]interface{}-based callers had to pattern-match.
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+	Raw     []byte
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("admin api error: status=%d code=%s message=%s", e.Status, e.Code, e.Message)
+	}
+	return fmt.Sprintf("admin api error: status=%d body=%s", e.Status, string(e.Raw))
+}
+
+// IsClientError reports whether the response was a 4xx.
+func (e *APIError) IsClientError() bool {
+	return e.Status >= 400 && e.Status < 500
+}
+
+// IsServerError reports whether the response was a 5xx.
+func (e *APIError) IsServerError() bool {
+	return e.Status >= 500
+}
+
+// errorBody is the shape generated clients expect an admin error response
+// to take; unrecognized fields are simply left zero-valued.
+type errorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ParseAPIError builds an *APIError from a non-2xx status and its raw
+// response body, decoding a {code, message} envelope when present.
+func ParseAPIError(status int, body []byte) *APIError {
+	apiErr := &APIError{Status: status, Raw: body}
+
+	var parsed errorBody
+	if err := json.Unmarshal(body, &parsed); err == nil {
+		apiErr.Code = parsed.Code
+		apiErr.Message = parsed.Message
+	}
+
+	return apiErr
+}
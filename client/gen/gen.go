@@ -0,0 +1,41 @@
+// Package gen holds the small runtime support used by code that
+// cmd/admin-gen emits on top of PaanjAdmin: typed event dispatch and typed
+// HTTP errors. It has no dependency on the admin package so generated code
+// in admin can import it without a cycle.
+package gen
+
+import "encoding/json"
+
+// EventSource is the subset of AdminWebSocketClient/Transport that OnTyped
+// needs. *PaanjAdmin and *AdminWebSocketClient both satisfy it already.
+type EventSource interface {
+	On(event string, callback func(interface{}))
+}
+
+// OnTyped registers a type-safe handler for event: the untyped payload is
+// round-tripped through JSON into T before callback runs. Payloads that
+// don't decode into T are dropped rather than passed through, the same way
+// listen already drops frames it can't unmarshal.
+func OnTyped[T any](source EventSource, event string, callback func(T)) {
+	source.On(event, func(data interface{}) {
+		typed, err := decode[T](data)
+		if err != nil {
+			return
+		}
+		callback(typed)
+	})
+}
+
+func decode[T any](data interface{}) (T, error) {
+	var out T
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		return out, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,35 @@
+package gen
+
+import "net/http"
+
+// RequestInterceptor runs on an outgoing request before it's sent, so
+// callers can plug in auth refresh, tracing headers, etc. Returning an
+// error aborts the request.
+type RequestInterceptor func(req *http.Request) error
+
+// ResponseInterceptor runs on the response before its body is decoded, so
+// callers can plug in retry-on-401 or logging. Returning an error aborts
+// the call with that error instead of decoding the body.
+type ResponseInterceptor func(resp *http.Response) error
+
+// RunRequestInterceptors runs each interceptor in order, stopping at the
+// first error.
+func RunRequestInterceptors(interceptors []RequestInterceptor, req *http.Request) error {
+	for _, intercept := range interceptors {
+		if err := intercept(req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RunResponseInterceptors runs each interceptor in order, stopping at the
+// first error.
+func RunResponseInterceptors(interceptors []ResponseInterceptor, resp *http.Response) error {
+	for _, intercept := range interceptors {
+		if err := intercept(resp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package gen
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// EncodeQuery builds a URL query string (including the leading "?", or ""
+// if every field is empty or excluded) from the json-tagged fields of a
+// cmd/admin-gen Params struct. Fields tagged "-" (path parameters, folded
+// into the path by the generated method instead) are skipped, and a
+// ",omitempty" field whose value is its zero value is skipped the same way
+// encoding/json would omit it from a body. v must be a struct, not a
+// pointer; generated Params types are always passed by value.
+func EncodeQuery(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+
+	values := url.Values{}
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		name, omitempty, ok := queryTag(rt.Field(i).Tag.Get("json"))
+		if !ok {
+			continue
+		}
+
+		field := rv.Field(i)
+		if omitempty && field.IsZero() {
+			continue
+		}
+
+		values.Set(name, fmt.Sprint(field.Interface()))
+	}
+
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// queryTag parses a struct json tag into its query parameter name, reporting
+// ok=false for fields with no tag or a "-" tag.
+func queryTag(tag string) (name string, omitempty bool, ok bool) {
+	if tag == "" || tag == "-" {
+		return "", false, false
+	}
+
+	parts := strings.Split(tag, ",")
+	for _, p := range parts[1:] {
+		if p == "omitempty" {
+			omitempty = true
+		}
+	}
+	return parts[0], omitempty, true
+}
@@ -0,0 +1,40 @@
+package gen
+
+import "testing"
+
+func TestEncodeQuery(t *testing.T) {
+	type params struct {
+		Limit  int    `json:"limit,omitempty"`
+		Cursor string `json:"cursor,omitempty"`
+		ID     string `json:"-"`
+	}
+
+	tests := []struct {
+		name string
+		in   params
+		want string
+	}{
+		{name: "all zero", in: params{}, want: ""},
+		{name: "path field ignored", in: params{ID: "u_1"}, want: ""},
+		{name: "single field", in: params{Limit: 25}, want: "?limit=25"},
+		{
+			name: "multiple fields",
+			in:   params{Limit: 25, Cursor: "abc", ID: "u_1"},
+			want: "?cursor=abc&limit=25",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EncodeQuery(tt.in); got != tt.want {
+				t.Errorf("EncodeQuery(%+v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryNonStruct(t *testing.T) {
+	if got := EncodeQuery("not a struct"); got != "" {
+		t.Errorf("EncodeQuery(non-struct) = %q, want \"\"", got)
+	}
+}
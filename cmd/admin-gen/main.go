@@ -0,0 +1,375 @@
+// Command admin-gen reads an OpenAPI v3 document describing the admin API
+// and emits typed PaanjAdmin methods plus their request/response structs,
+// replacing the map[string]interface{} surface of AdminHttpClient.Request
+// for endpoints it covers. See admin_gen.go for a checked-in example of its
+// output and testdata/admin.json for the spec that produced it.
+//
+// Usage:
+//
+//	go run ./cmd/admin-gen -spec path/to/admin.json -out admin_gen.go -package admin
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+type spec struct {
+	Paths      map[string]map[string]operation `json:"paths"`
+	Components struct {
+		Schemas map[string]schema `json:"schemas"`
+	} `json:"components"`
+	// XEvents maps a websocket/SSE event type string to the component
+	// schema describing its payload. This is an admin-gen specific
+	// extension; it has no meaning to general OpenAPI tooling.
+	XEvents map[string]string `json:"x-events"`
+}
+
+type operation struct {
+	OperationID string              `json:"operationId"`
+	Parameters  []parameter         `json:"parameters"`
+	RequestBody *requestBody        `json:"requestBody"`
+	Responses   map[string]response `json:"responses"`
+}
+
+type parameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"`
+	Required bool   `json:"required"`
+	Schema   schema `json:"schema"`
+}
+
+type requestBody struct {
+	Content map[string]mediaType `json:"content"`
+}
+
+type response struct {
+	Content map[string]mediaType `json:"content"`
+}
+
+type mediaType struct {
+	Schema schema `json:"schema"`
+}
+
+type schema struct {
+	Ref        string            `json:"$ref"`
+	Type       string            `json:"type"`
+	Properties map[string]schema `json:"properties"`
+	Items      *schema           `json:"items"`
+}
+
+func main() {
+	specPath := flag.String("spec", "", "path to the OpenAPI v3 JSON document")
+	outPath := flag.String("out", "", "output .go file path")
+	pkg := flag.String("package", "admin", "package name for the generated file")
+	flag.Parse()
+
+	if *specPath == "" || *outPath == "" {
+		fmt.Fprintln(os.Stderr, "usage: admin-gen -spec admin.json -out admin_gen.go [-package admin]")
+		os.Exit(2)
+	}
+
+	if err := run(*specPath, *outPath, *pkg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(specPath, outPath, pkg string) error {
+	raw, err := os.ReadFile(specPath)
+	if err != nil {
+		return fmt.Errorf("failed to read spec: %w", err)
+	}
+
+	var s spec
+	if err := json.Unmarshal(raw, &s); err != nil {
+		return fmt.Errorf("failed to parse spec: %w", err)
+	}
+
+	data, err := buildTemplateData(&s, specPath, pkg)
+	if err != nil {
+		return fmt.Errorf("failed to build generator input: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to render template: %w", err)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("failed to gofmt generated source: %w", err)
+	}
+
+	if err := os.WriteFile(outPath, formatted, 0o644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	return nil
+}
+
+type templateData struct {
+	SpecPath string
+	Package  string
+	Schemas  []schemaType
+	Ops      []opMethod
+	Events   []eventHandler
+}
+
+type schemaType struct {
+	Name   string
+	Fields []structField
+}
+
+type structField struct {
+	Name string
+	Type string
+	Tag  string
+}
+
+type opMethod struct {
+	Name         string
+	Method       string
+	Path         string
+	PathFormat   string // path with {x} replaced by %s
+	PathArgs     []string
+	ParamsType   string
+	ParamsFields []structField // only set when Params is synthesized, not a component schema
+	HasBody      bool          // true when ParamsType came from requestBody and is sent as the JSON body
+	ResponseType string
+}
+
+type eventHandler struct {
+	Event      string
+	MethodName string
+	Type       string
+}
+
+func buildTemplateData(s *spec, specPath, pkg string) (*templateData, error) {
+	data := &templateData{SpecPath: specPath, Package: pkg}
+
+	for _, name := range sortedKeys(s.Components.Schemas) {
+		sc := s.Components.Schemas[name]
+		if sc.Type != "object" && len(sc.Properties) == 0 {
+			continue
+		}
+		data.Schemas = append(data.Schemas, schemaType{Name: name, Fields: structFields(sc)})
+	}
+
+	for _, path := range sortedKeys(s.Paths) {
+		for _, method := range sortedKeys(s.Paths[path]) {
+			op := s.Paths[path][method]
+			if op.OperationID == "" {
+				continue
+			}
+
+			m := opMethod{
+				Name:   op.OperationID,
+				Method: strings.ToUpper(method),
+				Path:   path,
+			}
+
+			pathFormat, pathArgs := formatPath(path)
+			m.PathFormat, m.PathArgs = pathFormat, pathArgs
+
+			switch {
+			case op.RequestBody != nil:
+				ref := op.RequestBody.Content["application/json"].Schema.Ref
+				m.ParamsType = schemaNameFromRef(ref)
+				m.HasBody = true
+			case len(op.Parameters) > 0:
+				// Parameters here are "in": "path" or "in": "query" only -
+				// path ones are consumed by PathFormat/PathArgs above and
+				// tagged "-" so gen.EncodeQuery skips them; query ones are
+				// appended to the URL via gen.EncodeQuery, never sent as a
+				// JSON body.
+				m.ParamsType = op.OperationID + "Params"
+				for _, p := range op.Parameters {
+					tag := p.Name + ",omitempty"
+					if p.In == "path" {
+						tag = "-"
+					}
+					m.ParamsFields = append(m.ParamsFields, structField{
+						Name: exportedName(p.Name),
+						Type: goType(p.Schema),
+						Tag:  tag,
+					})
+				}
+			}
+
+			if ok200, found := op.Responses["200"]; found {
+				m.ResponseType = schemaNameFromRef(ok200.Content["application/json"].Schema.Ref)
+			}
+
+			data.Ops = append(data.Ops, m)
+		}
+	}
+
+	for _, event := range sortedKeys(s.XEvents) {
+		typeName := s.XEvents[event]
+		data.Events = append(data.Events, eventHandler{
+			Event:      event,
+			MethodName: "On" + exportedName(strings.ReplaceAll(event, ".", "_")),
+			Type:       typeName,
+		})
+	}
+
+	return data, nil
+}
+
+func structFields(sc schema) []structField {
+	var fields []structField
+	for _, name := range sortedKeys(sc.Properties) {
+		fields = append(fields, structField{
+			Name: exportedName(name),
+			Type: goType(sc.Properties[name]),
+			Tag:  name + ",omitempty",
+		})
+	}
+	return fields
+}
+
+func goType(sc schema) string {
+	if sc.Ref != "" {
+		return schemaNameFromRef(sc.Ref)
+	}
+	switch sc.Type {
+	case "integer":
+		return "int"
+	case "number":
+		return "float64"
+	case "boolean":
+		return "bool"
+	case "array":
+		if sc.Items != nil {
+			return "[]" + goType(*sc.Items)
+		}
+		return "[]interface{}"
+	case "object":
+		return "map[string]interface{}"
+	default:
+		return "string"
+	}
+}
+
+func schemaNameFromRef(ref string) string {
+	const prefix = "#/components/schemas/"
+	return strings.TrimPrefix(ref, prefix)
+}
+
+var pathParamPattern = regexp.MustCompile(`\{([^}]+)\}`)
+
+// formatPath turns "/admin/users/{id}" into ("/admin/users/%s", ["ID"]).
+func formatPath(path string) (string, []string) {
+	var args []string
+	format := pathParamPattern.ReplaceAllStringFunc(path, func(m string) string {
+		name := m[1 : len(m)-1]
+		args = append(args, exportedName(name))
+		return "%s"
+	})
+	return format, args
+}
+
+// initialisms are upper-cased in full, matching Go style (ID, not Id).
+var initialisms = map[string]string{
+	"id":   "ID",
+	"url":  "URL",
+	"api":  "API",
+	"http": "HTTP",
+}
+
+func exportedName(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '_' || r == '-'
+	})
+	var b strings.Builder
+	for _, p := range parts {
+		if p == "" {
+			continue
+		}
+		if upper, ok := initialisms[strings.ToLower(p)]; ok {
+			b.WriteString(upper)
+			continue
+		}
+		b.WriteString(strings.ToUpper(p[:1]))
+		b.WriteString(p[1:])
+	}
+	if b.Len() == 0 {
+		return name
+	}
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var fileTemplate = template.Must(template.New("admin_gen").Parse(`// Code generated by cmd/admin-gen from {{.SpecPath}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/paanj-cloud/admin-go/client/gen"
+)
+
+{{range .Schemas}}
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Name}} {{.Type}} ` + "`json:\"{{.Tag}}\"`" + `
+{{- end}}
+}
+{{end}}
+{{range .Ops}}
+{{- if .ParamsFields}}
+type {{.ParamsType}} struct {
+{{- range .ParamsFields}}
+	{{.Name}} {{.Type}} ` + "`json:\"{{.Tag}}\"`" + `
+{{- end}}
+}
+{{end}}
+func (c *PaanjAdmin) {{.Name}}(ctx context.Context{{if .ParamsType}}, params {{.ParamsType}}{{end}}) (*{{.ResponseType}}, error) {
+	var out {{.ResponseType}}
+{{- if .PathArgs}}
+	path := fmt.Sprintf("{{.PathFormat}}"{{range .PathArgs}}, params.{{.}}{{end}})
+{{- if and .ParamsType (not .HasBody)}}
+	path += gen.EncodeQuery(params)
+{{- end}}
+	if err := c.httpClient.RequestTyped(ctx, "{{.Method}}", path, {{if .HasBody}}params{{else}}nil{{end}}, &out); err != nil {
+		return nil, err
+	}
+{{- else if and .ParamsType (not .HasBody)}}
+	path := "{{.Path}}" + gen.EncodeQuery(params)
+	if err := c.httpClient.RequestTyped(ctx, "{{.Method}}", path, nil, &out); err != nil {
+		return nil, err
+	}
+{{- else}}
+	if err := c.httpClient.RequestTyped(ctx, "{{.Method}}", "{{.Path}}", {{if .HasBody}}params{{else}}nil{{end}}, &out); err != nil {
+		return nil, err
+	}
+{{- end}}
+	return &out, nil
+}
+{{end}}
+{{range .Events}}
+// {{.MethodName}} registers a type-safe handler for the "{{.Event}}" event.
+func (c *PaanjAdmin) {{.MethodName}}(callback func({{.Type}})) {
+	gen.OnTyped(c, "{{.Event}}", callback)
+}
+{{end}}
+`))
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func generate(t *testing.T) string {
+	t.Helper()
+
+	outPath := filepath.Join(t.TempDir(), "admin_gen.go")
+	if err := run("testdata/admin.json", outPath, "admin"); err != nil {
+		t.Fatalf("run() failed: %v", err)
+	}
+
+	generated, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("failed to read generated output: %v", err)
+	}
+	return string(generated)
+}
+
+// TestQueryParamsUseQueryString guards the specific bug testdata/admin.json
+// exercises: "limit"/"cursor" are declared "in": "query" on ListUsers, so
+// the generated method must build a query string and send a nil body on
+// its GET, not serialize params as the JSON request body.
+func TestQueryParamsUseQueryString(t *testing.T) {
+	generated := generate(t)
+
+	const wantCall = `c.httpClient.RequestTyped(ctx, "GET", path, nil, &out)`
+	if !strings.Contains(generated, wantCall) {
+		t.Errorf("expected ListUsers to call RequestTyped with a nil body and a query-encoded path, got:\n%s", generated)
+	}
+
+	const wantQuery = `path := "/admin/users" + gen.EncodeQuery(params)`
+	if !strings.Contains(generated, wantQuery) {
+		t.Errorf("expected ListUsers to build its path with gen.EncodeQuery, got:\n%s", generated)
+	}
+
+	const dontWant = `c.httpClient.RequestTyped(ctx, "GET", "/admin/users", params, &out)`
+	if strings.Contains(generated, dontWant) {
+		t.Errorf("ListUsers must not send query parameters as a JSON body, got:\n%s", generated)
+	}
+}
+
+// TestPathParamsStillEncodeQuery covers an operation with only a path
+// parameter (GetUser): it should still call gen.EncodeQuery (a no-op here,
+// since the id field is tagged "-") rather than special-casing "no query
+// fields" in the generator.
+func TestPathParamsStillEncodeQuery(t *testing.T) {
+	generated := generate(t)
+
+	const want = `path := fmt.Sprintf("/admin/users/%s", params.ID)
+	path += gen.EncodeQuery(params)`
+	if !strings.Contains(generated, want) {
+		t.Errorf("expected GetUser to append gen.EncodeQuery(params) after formatting its path, got:\n%s", generated)
+	}
+}
+
+// TestRequestBodyOperationsStillSendBody ensures the requestBody case
+// (CreateUser) keeps sending params as the JSON body, exactly as before the
+// query-string fix.
+func TestRequestBodyOperationsStillSendBody(t *testing.T) {
+	generated := generate(t)
+
+	const want = `c.httpClient.RequestTyped(ctx, "POST", "/admin/users", params, &out)`
+	if !strings.Contains(generated, want) {
+		t.Errorf("expected CreateUser to still send params as the request body, got:\n%s", generated)
+	}
+}
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+// TestExpirySweeperStopsOnDisconnect guards the goroutine leak where
+// expirySweeper, started unconditionally in NewAdminWebSocketClient, had
+// no way to ever stop. Disconnect should close stopSweep and let it exit.
+func TestExpirySweeperStopsOnDisconnect(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	clients := make([]*AdminWebSocketClient, 20)
+	for i := range clients {
+		clients[i] = NewAdminWebSocketClient("secret", "ws://example.invalid", false, time.Millisecond, 1)
+	}
+
+	for _, c := range clients {
+		c.Disconnect()
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if runtime.NumGoroutine() <= before+2 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("goroutine count did not settle after Disconnect: before=%d after=%d", before, runtime.NumGoroutine())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,56 @@
+package admin
+
+import "context"
+
+// Metrics is the instrumentation hook AdminHttpClient and
+// AdminWebSocketClient report into. The default is a no-op so the module
+// has zero required dependencies; pass metrics/prom.New(reg) (or your own
+// implementation) via AdminOptions.Metrics to wire up Prometheus.
+type Metrics interface {
+	// IncRequestsTotal counts one AdminHttpClient request attempt.
+	IncRequestsTotal(method, path string)
+	// IncRequestErrorsTotal counts one AdminHttpClient request that
+	// errored, either transport-level or a 4xx/5xx response.
+	IncRequestErrorsTotal(method, path string)
+	// ObserveRequestDuration records the wall-clock time of one
+	// AdminHttpClient request, successful or not.
+	ObserveRequestDuration(method, path string, seconds float64)
+	// IncWSReconnectsTotal counts one successful websocket reconnect
+	// (not the initial connect).
+	IncWSReconnectsTotal()
+	// SetWSConnected reports the current websocket connection state.
+	SetWSConnected(connected bool)
+	// IncWSMessagesReceivedTotal counts one inbound websocket frame,
+	// labeled by its "type" field.
+	IncWSMessagesReceivedTotal(eventType string)
+	// ObserveWSMessageDispatchSeconds records how long one event handler
+	// took to run for a frame, labeled by the frame's "type" field; a frame
+	// with several handlers is observed once per handler.
+	ObserveWSMessageDispatchSeconds(eventType string, seconds float64)
+}
+
+// Tracer starts an OpenTelemetry-shaped span without the module taking a
+// hard dependency on the OTel SDK. StartSpan returns the (possibly
+// derived) context to use for the traced operation and an end func to call
+// with the operation's error, if any, when it finishes.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, func(err error))
+}
+
+// noopMetrics is the default Metrics when AdminOptions.Metrics is nil.
+type noopMetrics struct{}
+
+func (noopMetrics) IncRequestsTotal(string, string)                {}
+func (noopMetrics) IncRequestErrorsTotal(string, string)           {}
+func (noopMetrics) ObserveRequestDuration(string, string, float64) {}
+func (noopMetrics) IncWSReconnectsTotal()                          {}
+func (noopMetrics) SetWSConnected(bool)                            {}
+func (noopMetrics) IncWSMessagesReceivedTotal(string)              {}
+func (noopMetrics) ObserveWSMessageDispatchSeconds(string, float64) {}
+
+// noopTracer is the default Tracer when AdminOptions.Tracer is nil.
+type noopTracer struct{}
+
+func (noopTracer) StartSpan(ctx context.Context, name string) (context.Context, func(err error)) {
+	return ctx, func(error) {}
+}
@@ -0,0 +1,101 @@
+// Package prom adapts admin.Metrics to prometheus/client_golang. It lives in
+// its own nested module (see go.mod next to this file) so that importing it
+// - and pulling in prometheus/client_golang and its transitive deps - is
+// opt-in: the root github.com/paanj-cloud/admin-go module never requires
+// Prometheus just because this subpackage exists in the same repo.
+package prom
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics implements admin.Metrics by registering its series on reg.
+type Metrics struct {
+	requestsTotal      *prometheus.CounterVec
+	requestErrorsTotal *prometheus.CounterVec
+	requestDuration    *prometheus.HistogramVec
+	wsReconnectsTotal  prometheus.Counter
+	wsConnected        prometheus.Gauge
+	wsMessagesReceived *prometheus.CounterVec
+	wsMessageDispatch  *prometheus.HistogramVec
+}
+
+// New registers the admin SDK's metric series on reg and returns a Metrics
+// ready to pass as AdminOptions.Metrics. Use prometheus.DefaultRegisterer
+// unless the caller wants an isolated registry.
+func New(reg prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		requestsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "admin_requests_total",
+			Help: "Total AdminHttpClient requests attempted, by method and path.",
+		}, []string{"method", "path"}),
+		requestErrorsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "admin_request_errors_total",
+			Help: "Total AdminHttpClient requests that errored, by method and path.",
+		}, []string{"method", "path"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "admin_request_duration_seconds",
+			Help: "AdminHttpClient request duration in seconds, by method and path.",
+		}, []string{"method", "path"}),
+		wsReconnectsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "admin_ws_reconnects_total",
+			Help: "Total successful websocket reconnects.",
+		}),
+		wsConnected: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "admin_ws_connected",
+			Help: "Whether the websocket is currently connected (1) or not (0).",
+		}),
+		wsMessagesReceived: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "admin_ws_messages_received_total",
+			Help: "Total inbound websocket frames, by event type.",
+		}, []string{"type"}),
+		wsMessageDispatch: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name: "admin_ws_message_dispatch_seconds",
+			Help: "Time spent running one event handler for a frame, by event type.",
+		}, []string{"type"}),
+	}
+
+	reg.MustRegister(
+		m.requestsTotal,
+		m.requestErrorsTotal,
+		m.requestDuration,
+		m.wsReconnectsTotal,
+		m.wsConnected,
+		m.wsMessagesReceived,
+		m.wsMessageDispatch,
+	)
+
+	return m
+}
+
+func (m *Metrics) IncRequestsTotal(method, path string) {
+	m.requestsTotal.WithLabelValues(method, path).Inc()
+}
+
+func (m *Metrics) IncRequestErrorsTotal(method, path string) {
+	m.requestErrorsTotal.WithLabelValues(method, path).Inc()
+}
+
+func (m *Metrics) ObserveRequestDuration(method, path string, seconds float64) {
+	m.requestDuration.WithLabelValues(method, path).Observe(seconds)
+}
+
+func (m *Metrics) IncWSReconnectsTotal() {
+	m.wsReconnectsTotal.Inc()
+}
+
+func (m *Metrics) SetWSConnected(connected bool) {
+	if connected {
+		m.wsConnected.Set(1)
+		return
+	}
+	m.wsConnected.Set(0)
+}
+
+func (m *Metrics) IncWSMessagesReceivedTotal(eventType string) {
+	m.wsMessagesReceived.WithLabelValues(eventType).Inc()
+}
+
+func (m *Metrics) ObserveWSMessageDispatchSeconds(eventType string, seconds float64) {
+	m.wsMessageDispatch.WithLabelValues(eventType).Observe(seconds)
+}
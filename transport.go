@@ -0,0 +1,106 @@
+package admin
+
+import "fmt"
+
+// Transport is the pluggable event/command channel behind PaanjAdmin. The
+// websocket implementation is the default; TransportSSE and
+// TransportLongPoll exist for environments (corporate proxies, load
+// balancers) that strip the Upgrade header a websocket handshake needs.
+type Transport interface {
+	Connect() error
+	Send(data interface{}) error
+	Subscribe(topic string, subscription interface{}) error
+	On(event string, callback func(interface{}))
+	Close()
+}
+
+// TransportKind selects a Transport implementation for TransportPreference.
+type TransportKind int
+
+const (
+	TransportWS TransportKind = iota
+	TransportSSE
+	TransportLongPoll
+)
+
+// wsTransport adapts AdminWebSocketClient to the Transport interface.
+type wsTransport struct {
+	client *AdminWebSocketClient
+}
+
+func (t *wsTransport) Connect() error {
+	return t.client.Connect()
+}
+
+func (t *wsTransport) Send(data interface{}) error {
+	return t.client.Send(data)
+}
+
+func (t *wsTransport) Subscribe(topic string, subscription interface{}) error {
+	return t.client.Subscribe(topic, subscription)
+}
+
+func (t *wsTransport) On(event string, callback func(interface{})) {
+	t.client.On(event, callback)
+}
+
+func (t *wsTransport) Close() {
+	t.client.Disconnect()
+}
+
+// buildTransport constructs the Transport for kind, wiring it up from
+// params the same way NewAdmin wires the default websocket client.
+func buildTransport(kind TransportKind, secretKey string, params AdminOptions) Transport {
+	switch kind {
+	case TransportSSE:
+		return newSSETransport(secretKey, params.ApiUrl)
+	case TransportLongPoll:
+		return newLongPollTransport(secretKey, params.ApiUrl)
+	default:
+		client := NewAdminWebSocketClient(
+			secretKey,
+			params.WsUrl,
+			params.AutoReconnect,
+			params.ReconnectInterval,
+			params.MaxReconnectAttempts,
+		)
+		client.maxReconnectInterval = params.MaxReconnectInterval
+		client.pingInterval = params.PingInterval
+		client.pongWait = params.PongWait
+		client.writeWait = params.WriteWait
+		client.slowConsumerPolicy = params.SlowConsumerPolicy
+		client.chanBufferSize = params.ChannelBufferSize
+		client.metrics = params.Metrics
+		client.tracer = params.Tracer
+		return &wsTransport{client: client}
+	}
+}
+
+// connectTransports tries each candidate in order, returning the first one
+// that connects successfully. This is the "negotiate downward" behavior
+// TransportPreference describes: a WS failure (e.g. a proxy rejecting the
+// Upgrade) falls through to SSE, then long-poll.
+//
+// A candidate whose Connect() fails is left alone, not Close()d: Connect
+// only sets up per-connection state on success, so a failed attempt has
+// nothing to tear down, and for a wsTransport, Close() would also kill the
+// client's long-lived janitor goroutines (e.g. expirySweeper) that are
+// meant to survive across retries of that same candidate. Only the untried
+// remainder once a winner is found gets Close()d here, since buildTransport
+// already constructed those candidates (and, for a websocket candidate,
+// already started its background goroutines) before connectTransports ever
+// saw them.
+func connectTransports(candidates []Transport) (Transport, error) {
+	var lastErr error
+	for i, t := range candidates {
+		if err := t.Connect(); err != nil {
+			lastErr = err
+			continue
+		}
+		for _, unused := range candidates[i+1:] {
+			unused.Close()
+		}
+		return t, nil
+	}
+	return nil, fmt.Errorf("no transport could connect: %w", lastErr)
+}
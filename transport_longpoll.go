@@ -0,0 +1,189 @@
+package admin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// longPollRetryInterval is how long the poll loop backs off after a failed
+// /poll/admin request before trying again.
+const longPollRetryInterval = 2 * time.Second
+
+// longPollFrame is one event as returned in a /poll/admin batch.
+type longPollFrame struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// longPollTransport receives events by repeatedly POSTing its last cursor
+// to /poll/admin and dispatching whatever batch comes back, trading
+// latency for working through infrastructure that blocks both websockets
+// and SSE.
+type longPollTransport struct {
+	secretKey string
+	apiUrl    string
+	client    *http.Client
+
+	mu            sync.Mutex
+	eventHandlers map[string][]func(interface{})
+
+	cursor string
+	stopCh chan struct{}
+}
+
+func newLongPollTransport(secretKey, apiUrl string) *longPollTransport {
+	return &longPollTransport{
+		secretKey:     secretKey,
+		apiUrl:        apiUrl,
+		client:        &http.Client{},
+		eventHandlers: make(map[string][]func(interface{})),
+	}
+}
+
+// Connect makes one synchronous /poll/admin request so a caller (including
+// connectTransports negotiating downward from WS/SSE) finds out immediately
+// if long-poll isn't reachable either, instead of only learning it from a
+// background "long-poll error:" log line. pollLoop takes over from there.
+func (t *longPollTransport) Connect() error {
+	batch, nextCursor, err := t.poll()
+	if err != nil {
+		return fmt.Errorf("long-poll connect failed: %w", err)
+	}
+	t.cursor = nextCursor
+	t.dispatchBatch(batch)
+
+	t.stopCh = make(chan struct{})
+	go t.pollLoop(t.stopCh)
+	return nil
+}
+
+func (t *longPollTransport) pollLoop(stop chan struct{}) {
+	for {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		batch, nextCursor, err := t.poll()
+		if err != nil {
+			log.Println("long-poll error:", err)
+			time.Sleep(longPollRetryInterval)
+			continue
+		}
+
+		t.cursor = nextCursor
+		t.dispatchBatch(batch)
+	}
+}
+
+func (t *longPollTransport) dispatchBatch(batch []longPollFrame) {
+	for _, frame := range batch {
+		var data interface{}
+		if len(frame.Data) > 0 {
+			if err := json.Unmarshal(frame.Data, &data); err != nil {
+				continue
+			}
+		}
+
+		t.mu.Lock()
+		handlers := t.eventHandlers[frame.Type]
+		t.mu.Unlock()
+
+		for _, handler := range handlers {
+			go handler(data)
+		}
+	}
+}
+
+func (t *longPollTransport) poll() ([]longPollFrame, string, error) {
+	body, err := json.Marshal(map[string]string{"cursor": t.cursor})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal poll request: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.apiUrl+"/poll/admin", bytes.NewBuffer(body))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", t.secretKey)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("poll request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return nil, "", fmt.Errorf("api error: status=%d body=%s", resp.StatusCode, string(responseBody))
+	}
+
+	var result struct {
+		Cursor string          `json:"cursor"`
+		Events []longPollFrame `json:"events"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, "", fmt.Errorf("failed to decode poll response: %w", err)
+	}
+
+	return result.Events, result.Cursor, nil
+}
+
+func (t *longPollTransport) Send(data interface{}) error {
+	return t.post("/admin/send", data)
+}
+
+func (t *longPollTransport) Subscribe(topic string, subscription interface{}) error {
+	return t.post("/admin/subscribe", map[string]interface{}{
+		"topic": topic,
+		"data":  subscription,
+	})
+}
+
+func (t *longPollTransport) post(path string, body interface{}) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.apiUrl+path, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", t.secretKey)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("api error: status=%d body=%s", resp.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
+
+func (t *longPollTransport) On(event string, callback func(interface{})) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.eventHandlers[event] = append(t.eventHandlers[event], callback)
+}
+
+func (t *longPollTransport) Close() {
+	if t.stopCh != nil {
+		close(t.stopCh)
+		t.stopCh = nil
+	}
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLongPollTransportConnectPropagatesError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "poll endpoint missing", http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	transport := newLongPollTransport("secret", server.URL)
+
+	if err := transport.Connect(); err == nil {
+		t.Fatal("Connect() = nil, want an error from the initial poll")
+	}
+	if transport.stopCh != nil {
+		t.Error("Connect() must not start pollLoop when the initial poll fails")
+	}
+}
+
+func TestLongPollTransportConnectSucceeds(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"cursor":"c1","events":[]}`))
+	}))
+	defer server.Close()
+
+	transport := newLongPollTransport("secret", server.URL)
+	defer transport.Close()
+
+	if err := transport.Connect(); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+	if transport.cursor != "c1" {
+		t.Errorf("cursor = %q, want %q", transport.cursor, "c1")
+	}
+	if transport.stopCh == nil {
+		t.Error("Connect() must start pollLoop once the initial poll succeeds")
+	}
+}
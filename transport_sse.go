@@ -0,0 +1,219 @@
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sseRetryInterval is how long readLoop waits before redialing after the
+// stream ends, mirroring longPollRetryInterval's backoff for the long-poll
+// transport.
+const sseRetryInterval = 2 * time.Second
+
+// sseTransport receives events over a GET /events/admin Server-Sent Events
+// stream and sends commands as plain HTTP POSTs, so it works anywhere a
+// websocket Upgrade would be stripped but plain HTTP keep-alive survives.
+type sseTransport struct {
+	secretKey string
+	apiUrl    string
+	client    *http.Client
+
+	mu            sync.Mutex
+	eventHandlers map[string][]func(interface{})
+
+	resp    *http.Response
+	closeCh chan struct{}
+}
+
+func newSSETransport(secretKey, apiUrl string) *sseTransport {
+	return &sseTransport{
+		secretKey:     secretKey,
+		apiUrl:        apiUrl,
+		client:        &http.Client{},
+		eventHandlers: make(map[string][]func(interface{})),
+	}
+}
+
+func (t *sseTransport) Connect() error {
+	resp, err := t.dial()
+	if err != nil {
+		return err
+	}
+
+	t.resp = resp
+	t.closeCh = make(chan struct{})
+
+	go t.readLoop(resp, t.closeCh)
+
+	return nil
+}
+
+// dial issues the GET /events/admin request that opens the event stream.
+func (t *sseTransport) dial() (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, t.apiUrl+"/events/admin", nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create sse request: %w", err)
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("X-API-Key", t.secretKey)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sse connect failed: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		responseBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		return nil, fmt.Errorf("sse connect failed: status=%d body=%s", resp.StatusCode, string(responseBody))
+	}
+
+	return resp, nil
+}
+
+// readLoop scans the stream line by line, dispatching each "data: {...}"
+// frame, until the stream ends (EOF, idle timeout, proxy reset). Rather
+// than dying there, it redials after sseRetryInterval and keeps scanning -
+// the same resilience keepalive/scheduleReconnect gives the websocket
+// transport - and only returns once stop is closed.
+func (t *sseTransport) readLoop(resp *http.Response, stop chan struct{}) {
+	for {
+		t.scan(resp, stop)
+
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		select {
+		case <-stop:
+			return
+		case <-time.After(sseRetryInterval):
+		}
+
+		next, err := t.dial()
+		if err != nil {
+			log.Println("sse reconnect error:", err)
+			resp = nil
+			continue
+		}
+
+		resp = next
+		t.mu.Lock()
+		t.resp = resp
+		t.mu.Unlock()
+	}
+}
+
+// scan reads frames off resp until the stream ends or stop is closed,
+// closing resp's body either way.
+func (t *sseTransport) scan(resp *http.Response, stop chan struct{}) {
+	if resp == nil {
+		return
+	}
+	defer resp.Body.Close()
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		select {
+		case <-stop:
+			return
+		default:
+		}
+
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		payload := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+
+		var frame struct {
+			Type string          `json:"type"`
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal([]byte(payload), &frame); err != nil {
+			continue
+		}
+
+		var data interface{}
+		if len(frame.Data) > 0 {
+			if err := json.Unmarshal(frame.Data, &data); err != nil {
+				continue
+			}
+		}
+
+		t.mu.Lock()
+		handlers := t.eventHandlers[frame.Type]
+		t.mu.Unlock()
+
+		for _, handler := range handlers {
+			go handler(data)
+		}
+	}
+}
+
+func (t *sseTransport) Send(data interface{}) error {
+	return t.post("/admin/send", data)
+}
+
+func (t *sseTransport) Subscribe(topic string, subscription interface{}) error {
+	return t.post("/admin/subscribe", map[string]interface{}{
+		"topic": topic,
+		"data":  subscription,
+	})
+}
+
+func (t *sseTransport) post(path string, body interface{}) error {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return fmt.Errorf("failed to marshal request body: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, t.apiUrl+path, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-API-Key", t.secretKey)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		responseBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("api error: status=%d body=%s", resp.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
+
+func (t *sseTransport) On(event string, callback func(interface{})) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.eventHandlers[event] = append(t.eventHandlers[event], callback)
+}
+
+func (t *sseTransport) Close() {
+	if t.closeCh != nil {
+		close(t.closeCh)
+		t.closeCh = nil
+	}
+	t.mu.Lock()
+	resp := t.resp
+	t.mu.Unlock()
+	if resp != nil {
+		resp.Body.Close()
+	}
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// TestSSETransportReadLoopReconnects guards the resiliency gap: once the
+// stream ends (here: the handler closes it after one frame), readLoop must
+// redial rather than silently stopping delivery.
+func TestSSETransportReadLoopReconnects(t *testing.T) {
+	var connects int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&connects, 1)
+		w.Header().Set("Content-Type", "text/event-stream")
+		fmt.Fprintf(w, "data: {\"type\":\"tick\",\"data\":%d}\n\n", n)
+		// The handler returning here ends the stream, simulating an idle
+		// timeout or proxy reset.
+	}))
+	defer server.Close()
+
+	transport := newSSETransport("secret", server.URL)
+	defer transport.Close()
+
+	var received int32
+	transport.On("tick", func(interface{}) {
+		atomic.AddInt32(&received, 1)
+	})
+
+	if err := transport.Connect(); err != nil {
+		t.Fatalf("Connect() error = %v", err)
+	}
+
+	// sseRetryInterval is 2s, so allow enough time for at least one redial
+	// after the initial connect.
+	deadline := time.Now().Add(3 * time.Second)
+	for atomic.LoadInt32(&connects) < 2 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if got := atomic.LoadInt32(&connects); got < 2 {
+		t.Fatalf("server saw %d connects, want at least 2 (readLoop should redial after the stream ends)", got)
+	}
+	if atomic.LoadInt32(&received) == 0 {
+		t.Error("no \"tick\" events were delivered across reconnects")
+	}
+}
@@ -0,0 +1,133 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// fakeTransport is a minimal Transport used to exercise connectTransports
+// and PaanjAdmin without a real network connection.
+type fakeTransport struct {
+	connectErr error
+	connected  bool
+	closed     bool
+
+	handlers   map[string][]func(interface{})
+	subscribed map[string]interface{}
+}
+
+func (t *fakeTransport) Connect() error {
+	if t.connectErr != nil {
+		return t.connectErr
+	}
+	t.connected = true
+	return nil
+}
+
+func (t *fakeTransport) Send(interface{}) error { return nil }
+
+func (t *fakeTransport) Subscribe(topic string, subscription interface{}) error {
+	if t.subscribed == nil {
+		t.subscribed = make(map[string]interface{})
+	}
+	t.subscribed[topic] = subscription
+	return nil
+}
+
+func (t *fakeTransport) On(event string, callback func(interface{})) {
+	if t.handlers == nil {
+		t.handlers = make(map[string][]func(interface{}))
+	}
+	t.handlers[event] = append(t.handlers[event], callback)
+}
+
+func (t *fakeTransport) Close() { t.closed = true }
+
+func TestConnectTransportsClosesOnlyUntried(t *testing.T) {
+	failing := &fakeTransport{connectErr: errors.New("ws upgrade rejected")}
+	winner := &fakeTransport{}
+	untried := &fakeTransport{}
+
+	got, err := connectTransports([]Transport{failing, winner, untried})
+	if err != nil {
+		t.Fatalf("connectTransports() error = %v", err)
+	}
+	if got != winner {
+		t.Fatalf("connectTransports() = %v, want the first candidate that connects", got)
+	}
+
+	if failing.closed {
+		t.Error("a candidate whose Connect() failed must not be Close()d - it has nothing to tear down, and Close() would kill janitor goroutines meant to survive a retry")
+	}
+	if winner.closed {
+		t.Error("the winning transport must stay open, not be closed")
+	}
+	if !untried.closed {
+		t.Error("an untried candidate (already constructed by buildTransport) was never Close()d")
+	}
+}
+
+func TestConnectTransportsAllFail(t *testing.T) {
+	a := &fakeTransport{connectErr: errors.New("a failed")}
+	b := &fakeTransport{connectErr: errors.New("b failed")}
+
+	_, err := connectTransports([]Transport{a, b})
+	if err == nil {
+		t.Fatal("connectTransports() expected an error when every candidate fails")
+	}
+	if a.closed || b.closed {
+		t.Error("a failed candidate must not be Close()d, so the caller can retry it later without losing its background goroutines")
+	}
+}
+
+// TestConnectTransportsRetryKeepsSweeperAlive guards the chunk0-2 fix against
+// regressing: a wsTransport candidate whose first Connect() fails (nothing
+// listening yet) must still have a live expirySweeper by the time a later
+// Connect() against the same candidate succeeds.
+func TestConnectTransportsRetryKeepsSweeperAlive(t *testing.T) {
+	// A server that's already closed gives us a dead address to dial against,
+	// forcing the first Connect() to fail.
+	dead := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	deadUrl := "ws://" + strings.TrimPrefix(dead.URL, "http://")
+	dead.Close()
+
+	client := NewAdminWebSocketClient("secret", deadUrl, false, time.Millisecond, 1)
+	candidate := &wsTransport{client: client}
+
+	if _, err := connectTransports([]Transport{candidate}); err == nil {
+		t.Fatal("connectTransports() expected an error with nothing listening yet")
+	}
+
+	select {
+	case <-client.stopSweep:
+		t.Fatal("expirySweeper's stop channel was closed after a failed Connect(), it should only close on an explicit Disconnect")
+	default:
+	}
+
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			if _, _, err := conn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+	client.wsUrl = "ws://" + strings.TrimPrefix(server.URL, "http://")
+	defer client.Disconnect()
+
+	if _, err := connectTransports([]Transport{candidate}); err != nil {
+		t.Fatalf("connectTransports() retry error = %v, want the candidate to connect now that a server is listening", err)
+	}
+}